@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/scale-it/checkers/diff"
 	gc "gopkg.in/check.v1"
 )
 
@@ -23,22 +24,78 @@ func (c *containsChecker) Check(params []interface{}, names []string) (result bo
 
 	switch cv.Kind() {
 	case reflect.Slice, reflect.Array:
-		if cv.Type().Elem() != vtype {
-			return false, ""
+		etype := cv.Type().Elem()
+		match, ok := containsMatcher(etype, vtype, vv)
+		if !ok {
+			return false, fmt.Sprintf("value of type %s is not compatible with container element type %s", vtype, etype)
 		}
 		for i := 0; i < cv.Len(); i++ {
-			if reflect.DeepEqual(cv.Index(i).Interface(), value) {
+			if match(cv.Index(i)) {
 				return true, ""
 			}
 		}
 		return false, ""
 	case reflect.String:
-		if vtype.Kind() != reflect.String {
-			return false, fmt.Sprint("value should have type: ", vtype)
+		return checkStringContains(cv, vtype, vv)
+	}
+	return false, fmt.Sprint("Unsupported argument types: ", cv.Kind(), vtype)
+}
+
+// containsMatcher returns a function reporting whether a container element of
+// type etype equals the probe value vv (of type vtype). It supports exact
+// type matches, interface-typed containers, pointer-vs-value struct
+// comparisons, and convertible types (e.g. an untyped int probe against a
+// []int64 container), following the same spirit as testify's
+// ObjectsAreEqualValues. ok is false when the types are incompatible
+// entirely.
+func containsMatcher(etype, vtype reflect.Type, vv reflect.Value) (match func(reflect.Value) bool, ok bool) {
+	switch {
+	case etype.Kind() == reflect.Interface || etype == vtype:
+		value := vv.Interface()
+		return func(e reflect.Value) bool {
+			return reflect.DeepEqual(e.Interface(), value)
+		}, true
+	case vtype.Kind() == reflect.Ptr && vtype.Elem() == etype:
+		if vv.IsNil() {
+			return func(reflect.Value) bool { return false }, true
 		}
+		value := vv.Elem().Interface()
+		return func(e reflect.Value) bool {
+			return reflect.DeepEqual(e.Interface(), value)
+		}, true
+	case etype.Kind() == reflect.Ptr && etype.Elem() == vtype:
+		value := vv.Interface()
+		return func(e reflect.Value) bool {
+			return !e.IsNil() && reflect.DeepEqual(e.Elem().Interface(), value)
+		}, true
+	case vtype.ConvertibleTo(etype):
+		converted := vv.Convert(etype)
+		// Guard against lossy conversions (e.g. a float probe like 2.9
+		// against an []int container): only accept the conversion if
+		// converting back reproduces the original probe exactly.
+		if !etype.ConvertibleTo(vtype) || !reflect.DeepEqual(converted.Convert(vtype).Interface(), vv.Interface()) {
+			return nil, false
+		}
+		value := converted.Interface()
+		return func(e reflect.Value) bool {
+			return reflect.DeepEqual(e.Interface(), value)
+		}, true
+	}
+	return nil, false
+}
+
+// checkStringContains implements Contains for a string container, additionally
+// accepting []byte and rune probes alongside plain strings.
+func checkStringContains(cv reflect.Value, vtype reflect.Type, vv reflect.Value) (result bool, error string) {
+	switch {
+	case vtype.Kind() == reflect.String:
 		return strings.Contains(cv.String(), vv.String()), ""
+	case vtype.Kind() == reflect.Slice && vtype.Elem().Kind() == reflect.Uint8:
+		return strings.Contains(cv.String(), string(vv.Bytes())), ""
+	case vtype.Kind() == reflect.Int32:
+		return strings.ContainsRune(cv.String(), rune(vv.Int())), ""
 	}
-	return false, fmt.Sprint("Unsupported argument types: ", cv.Kind(), vtype)
+	return false, fmt.Sprint("value should have type: string, []byte or rune, got ", vtype)
 }
 
 // Contains checker checks if an array, slice or string contains an element
@@ -73,9 +130,12 @@ func (c *sliceEquals) Check(params []interface{}, names []string) (result bool,
 	}
 	l := vs1.Len()
 	if l != vs2.Len() {
-		return false, ""
+		return false, fmt.Sprintf("len: %d != %d", l, vs2.Len())
 	}
-	return reflect.DeepEqual(s1, s2), ""
+	if reflect.DeepEqual(s1, s2) {
+		return true, ""
+	}
+	return false, diff.Summarize(s1, s2, diff.Options{})
 }
 
 // SliceEquals check if two slices has the same values
@@ -98,9 +158,12 @@ func (c *mapEquals) Check(params []interface{}, names []string) (result bool, er
 	}
 	l := vs1.Len()
 	if l != vs2.Len() {
-		return false, ""
+		return false, fmt.Sprintf("len: %d != %d", l, vs2.Len())
 	}
-	return reflect.DeepEqual(s1, s2), ""
+	if reflect.DeepEqual(s1, s2) {
+		return true, ""
+	}
+	return false, diff.Summarize(s1, s2, diff.Options{})
 }
 
 // MapEquals check if two maps has the same values
@@ -150,19 +213,68 @@ func (checker *sameContent) Check(params []interface{}, names []string) (result
 	length := vexp.Len()
 
 	if vob.Len() != length {
-		// Slice has incorrect number of elements
-		return false, ""
+		return false, fmt.Sprintf("len: %d != %d", vob.Len(), length)
+	}
+
+	// tob.Elem().Comparable() is true for an interface{} element type even
+	// when the dynamic value it holds isn't (e.g. a []int), which would
+	// panic when used as a map key below, so interface-kind elements always
+	// take the reflect.DeepEqual-based path.
+	if tob.Elem().Kind() != reflect.Interface && tob.Elem().Comparable() {
+		return checker.checkHashable(vob, vexp, length)
 	}
+	return checker.checkUnhashable(vob, vexp, length)
+}
+
+// checkHashable is the fast path for element types that can be used as map
+// keys: it counts the expected elements, then walks obtained consuming one
+// matching count per element. Since both slices were already checked to have
+// the same length, fully consuming obtained this way also means nothing is
+// left over on the expected side.
+func (checker *sameContent) checkHashable(vob, vexp reflect.Value, length int) (result bool, error string) {
+	remaining := make(map[interface{}]int, length)
+	for i := 0; i < length; i++ {
+		remaining[vexp.Index(i).Interface()]++
+	}
+	for i := 0; i < length; i++ {
+		v := vob.Index(i).Interface()
+		if remaining[v] <= 0 {
+			return false, fmt.Sprintf("obtained[%d] (%#v) has no matching element in expected", i, v)
+		}
+		remaining[v]--
+	}
+	return true, ""
+}
 
-	// spin up maps with the entries as keys and the counts as values
-	mob := make(map[interface{}]int, length)
-	mexp := make(map[interface{}]int, length)
+// checkUnhashable handles element types that cannot be map keys (slices,
+// maps, funcs, or structs containing them) by matching each obtained element
+// against an unmatched expected element via reflect.DeepEqual, O(n^2).
+func (checker *sameContent) checkUnhashable(vob, vexp reflect.Value, length int) (result bool, error string) {
+	matched := make([]bool, length)
 
 	for i := 0; i < length; i++ {
-		mexp[vexp.Index(i).Interface()]++
-		mob[vob.Index(i).Interface()]++
+		ov := vob.Index(i).Interface()
+		found := false
+		for j := 0; j < length; j++ {
+			if matched[j] {
+				continue
+			}
+			if reflect.DeepEqual(ov, vexp.Index(j).Interface()) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, fmt.Sprintf("obtained[%d] (%#v) has no matching element in expected", i, ov)
+		}
+	}
+	for j, ok := range matched {
+		if !ok {
+			return false, fmt.Sprintf("expected[%d] (%#v) has no matching element in obtained", j, vexp.Index(j).Interface())
+		}
 	}
-	return reflect.DeepEqual(mob, mexp), ""
+	return true, ""
 }
 
 // -----------------------------------------------------------------------
@@ -0,0 +1,39 @@
+package checkers_test
+
+import (
+	. "github.com/scale-it/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type SameContentSuite struct{}
+
+var _ = gc.Suite(&SameContentSuite{})
+
+func (s *SameContentSuite) TestSameContentIgnoresOrder(c *gc.C) {
+	c.Assert([]int{1, 2, 3}, SameContent, []int{3, 1, 2})
+}
+
+func (s *SameContentSuite) TestSameContentDetectsMismatch(c *gc.C) {
+	result, err := SameContent.Check([]interface{}{[]int{1, 2, 3}, []int{1, 2, 4}}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, gc.Matches, `obtained\[\d+\] \(\d+\) has no matching element in expected`)
+}
+
+// TestSameContentUnhashableElements is a regression test: slice elements are
+// unhashable (here, via an interface{} element type holding a slice), which
+// used to panic with "hash of unhashable type []int" because
+// reflect.Type.Comparable() is true for interface{} even when its dynamic
+// value isn't.
+func (s *SameContentSuite) TestSameContentUnhashableElements(c *gc.C) {
+	obtained := []interface{}{[]int{1, 2}, []int{3, 4}}
+	expected := []interface{}{[]int{3, 4}, []int{1, 2}}
+	c.Assert(obtained, SameContent, expected)
+}
+
+func (s *SameContentSuite) TestSameContentUnhashableMismatch(c *gc.C) {
+	obtained := []interface{}{[]int{1, 2}, []int{3, 4}}
+	expected := []interface{}{[]int{1, 2}, []int{9, 9}}
+	result, err := SameContent.Check([]interface{}{obtained, expected}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, Not(gc.Equals), "")
+}
@@ -0,0 +1,57 @@
+package checkers_test
+
+import (
+	. "github.com/scale-it/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type ContainsSuite struct{}
+
+var _ = gc.Suite(&ContainsSuite{})
+
+func (s *ContainsSuite) TestContainsExactType(c *gc.C) {
+	c.Assert([]int{1, 2, 3}, Contains, 2)
+}
+
+func (s *ContainsSuite) TestContainsConvertibleNumericType(c *gc.C) {
+	c.Assert([]int64{1, 2, 3}, Contains, 2)
+}
+
+func (s *ContainsSuite) TestContainsInterfaceSlice(c *gc.C) {
+	c.Assert([]interface{}{1, "two", 3.0}, Contains, "two")
+}
+
+func (s *ContainsSuite) TestContainsPointerProbeAgainstValueSlice(c *gc.C) {
+	type pair struct{ A, B int }
+	probe := &pair{A: 1, B: 2}
+	c.Assert([]pair{{1, 2}, {3, 4}}, Contains, probe)
+}
+
+func (s *ContainsSuite) TestContainsValueProbeAgainstPointerSlice(c *gc.C) {
+	type pair struct{ A, B int }
+	c.Assert([]*pair{{1, 2}, {3, 4}}, Contains, pair{3, 4})
+}
+
+// TestContainsRejectsLossyConversion is a regression test: converting the
+// probe to the element type before comparing used to accept lossy
+// conversions, so a float probe like 2.9 falsely matched an []int container
+// via int(2.9) == 2.
+func (s *ContainsSuite) TestContainsRejectsLossyConversion(c *gc.C) {
+	result, _ := Contains.Check([]interface{}{[]int{1, 2, 3}, 2.9}, []string{"Container", "Value"})
+	c.Assert(result, gc.Equals, false)
+}
+
+func (s *ContainsSuite) TestContainsIncompatibleTypesReportsError(c *gc.C) {
+	result, err := Contains.Check([]interface{}{[]int{1, 2, 3}, "two"}, []string{"Container", "Value"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, Not(gc.Equals), "")
+}
+
+func (s *ContainsSuite) TestContainsStringAcceptsBytesAndRune(c *gc.C) {
+	c.Assert("hello world", Contains, []byte("world"))
+	c.Assert("hello world", Contains, 'w')
+}
+
+func (s *ContainsSuite) TestIsIn(c *gc.C) {
+	c.Assert(2, IsIn, []int{1, 2, 3})
+}
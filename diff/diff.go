@@ -0,0 +1,299 @@
+// Package diff walks two values of the same type and produces a compact,
+// human readable list of the concrete differences between them. It is used
+// by the checkers package to turn a failed DeepEqual comparison into
+// something a developer can actually read, instead of dumping both values in
+// full.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Options controls how Diff walks and renders values.
+type Options struct {
+	// MaxDiffs caps the number of differences collected before Diff stops
+	// descending further. Zero means DefaultMaxDiffs.
+	MaxDiffs int
+
+	// FloatFormat is the fmt verb used to render float32/float64 values,
+	// e.g. "%.2f". Empty means DefaultFloatFormat.
+	FloatFormat string
+}
+
+// DefaultMaxDiffs is the MaxDiffs used when Options.MaxDiffs is zero.
+const DefaultMaxDiffs = 10
+
+// DefaultFloatFormat is the FloatFormat used when Options.FloatFormat is empty.
+const DefaultFloatFormat = "%.6g"
+
+func (o Options) maxDiffs() int {
+	if o.MaxDiffs > 0 {
+		return o.MaxDiffs
+	}
+	return DefaultMaxDiffs
+}
+
+func (o Options) floatFormat() string {
+	if o.FloatFormat != "" {
+		return o.FloatFormat
+	}
+	return DefaultFloatFormat
+}
+
+// Diff compares a and b with DefaultMaxDiffs and DefaultFloatFormat. See
+// DiffWithOptions.
+func Diff(a, b interface{}) []string {
+	return DiffWithOptions(a, b, Options{})
+}
+
+// DiffWithOptions recursively compares a and b and returns a list of the
+// differences found, one entry per difference, such as:
+//
+//	[2].Name: "foo" != "bar"
+//	len: 3 != 4
+//	key "x": missing in obtained
+//
+// Slices, arrays, maps, structs (including unexported fields), pointers and
+// interfaces are traversed; any other kind falls back to reflect.DeepEqual.
+// Collection stops once Options.MaxDiffs (DefaultMaxDiffs when zero)
+// differences have been found.
+func DiffWithOptions(a, b interface{}, opts Options) []string {
+	d := &differ{opts: opts}
+	d.walk("", box(a), box(b))
+	return d.diffs
+}
+
+// Summarize is a convenience wrapper that diffs a and b and renders the
+// result with Format, for callers that just want a single error string.
+func Summarize(a, b interface{}, opts Options) string {
+	d := &differ{opts: opts}
+	d.walk("", box(a), box(b))
+	return Format(d.diffs, d.truncated)
+}
+
+// Format joins the output of Diff/DiffWithOptions into a single line,
+// appending a " ... (more differences omitted)" marker when the list was
+// truncated.
+func Format(diffs []string, truncated bool) string {
+	s := ""
+	for i, line := range diffs {
+		if i > 0 {
+			s += "; "
+		}
+		s += line
+	}
+	if truncated {
+		s += " ... (more differences omitted)"
+	}
+	return s
+}
+
+type differ struct {
+	opts      Options
+	diffs     []string
+	truncated bool
+}
+
+func (d *differ) full() bool {
+	return len(d.diffs) >= d.opts.maxDiffs()
+}
+
+// stop reports whether the differ is full, and if so records that the walk
+// was cut short before covering everything.
+func (d *differ) stop() bool {
+	if d.full() {
+		d.truncated = true
+		return true
+	}
+	return false
+}
+
+func (d *differ) add(path, msg string) {
+	if d.full() {
+		d.truncated = true
+		return
+	}
+	if path == "" {
+		d.diffs = append(d.diffs, msg)
+	} else {
+		d.diffs = append(d.diffs, path+": "+msg)
+	}
+}
+
+func (d *differ) walk(path string, a, b reflect.Value) {
+	if d.stop() {
+		return
+	}
+
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			d.add(path, fmt.Sprintf("%s != %s", d.format(a), d.format(b)))
+		}
+		return
+	}
+
+	if a.Type() != b.Type() {
+		d.add(path, fmt.Sprintf("type %s != type %s", a.Type(), b.Type()))
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		aNil, bNil := a.IsNil(), b.IsNil()
+		if aNil || bNil {
+			if aNil != bNil {
+				d.add(path, fmt.Sprintf("%s != %s", d.format(a), d.format(b)))
+			}
+			return
+		}
+		d.walk(path, addressable(a.Elem()), addressable(b.Elem()))
+
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if d.stop() {
+				return
+			}
+			name := a.Type().Field(i).Name
+			d.walk(joinField(path, name), field(a, i), field(b, i))
+		}
+
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			d.add(path, fmt.Sprintf("len: %d != %d", a.Len(), b.Len()))
+			return
+		}
+		for i := 0; i < a.Len(); i++ {
+			if d.stop() {
+				return
+			}
+			d.walk(joinIndex(path, i), a.Index(i), b.Index(i))
+		}
+
+	case reflect.Map:
+		for _, k := range a.MapKeys() {
+			if d.stop() {
+				return
+			}
+			bv := b.MapIndex(k)
+			if !bv.IsValid() {
+				d.add(path, fmt.Sprintf("key %s: missing in expected", formatKey(d.iface(k))))
+				continue
+			}
+			d.walk(joinIndex(path, k.Interface()), addressable(a.MapIndex(k)), addressable(bv))
+		}
+		for _, k := range b.MapKeys() {
+			if d.stop() {
+				return
+			}
+			if !a.MapIndex(k).IsValid() {
+				d.add(path, fmt.Sprintf("key %s: missing in obtained", formatKey(d.iface(k))))
+			}
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if a.Float() != b.Float() {
+			d.add(path, fmt.Sprintf("%s != %s", d.format(a), d.format(b)))
+		}
+
+	default:
+		if !reflect.DeepEqual(d.iface(a), d.iface(b)) {
+			d.add(path, fmt.Sprintf("%s != %s", d.format(a), d.format(b)))
+		}
+	}
+}
+
+func (d *differ) format(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<invalid>"
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("%q", v.String())
+	case reflect.Float32, reflect.Float64:
+		return fmt.Sprintf(d.opts.floatFormat(), v.Float())
+	default:
+		return fmt.Sprintf("%v", d.iface(v))
+	}
+}
+
+// iface returns v as an interface{}, going through the unexported-field
+// workaround when necessary.
+func (d *differ) iface(v reflect.Value) interface{} {
+	return exported(v).Interface()
+}
+
+// formatKey renders a map key for an error message: quoted if it's a string,
+// %v otherwise so non-string keys (ints, structs, ...) stay legible instead
+// of being forced through %q.
+func formatKey(k interface{}) string {
+	if s, ok := k.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", k)
+}
+
+// joinField appends a struct field name to a path, e.g. "foo" + "Bar" ->
+// "foo.Bar".
+func joinField(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// joinIndex appends a slice/array/map index to a path, e.g. "foo" + 2 ->
+// "foo[2]".
+func joinIndex(path string, key interface{}) string {
+	return fmt.Sprintf("%s[%v]", path, key)
+}
+
+// box copies v into a freshly allocated, addressable value so that unexported
+// struct fields reached while walking it can be read via the unsafe.Pointer
+// trick in exported().
+func box(v interface{}) reflect.Value {
+	if v == nil {
+		return reflect.Value{}
+	}
+	rv := reflect.ValueOf(v)
+	boxed := reflect.New(rv.Type()).Elem()
+	boxed.Set(rv)
+	return boxed
+}
+
+// field reads struct field i of an addressable struct value v, working
+// around the reflect restriction on unexported fields.
+func field(v reflect.Value, i int) reflect.Value {
+	return exported(v.Field(i))
+}
+
+// addressable returns v, copied into a fresh addressable value if it isn't
+// already addressable. Values read out of maps, or reached through an
+// interface Elem(), are never addressable on their own, even when the
+// value they came from was (a pointer Elem() is, and is returned as is); struct fields read off of them would then panic
+// in exported() when the struct has unexported fields. v must not itself be
+// the product of an unexported struct field (exported() handles that case).
+func addressable(v reflect.Value) reflect.Value {
+	if !v.IsValid() || v.CanAddr() {
+		return v
+	}
+	boxed := reflect.New(v.Type()).Elem()
+	boxed.Set(v)
+	return boxed
+}
+
+// exported returns a value equivalent to v but usable with Interface(), even
+// if v came from an unexported struct field. That requires v to be
+// addressable; if it isn't (e.g. a field read off a non-addressable struct
+// that addressable() was never given the chance to box), there is no safe
+// way to read it, so a zero value is returned instead of panicking.
+func exported(v reflect.Value) reflect.Value {
+	if v.CanInterface() {
+		return v
+	}
+	if v.CanAddr() {
+		return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	return reflect.New(v.Type()).Elem()
+}
@@ -0,0 +1,116 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+type withUnexported struct {
+	Name   string
+	secret int
+}
+
+func TestDiffScalars(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b interface{}
+		want []string
+	}{
+		{"equal ints", 1, 1, nil},
+		{"different ints", 1, 2, []string{`1 != 2`}},
+		{"different strings", "foo", "bar", []string{`"foo" != "bar"`}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Diff(tc.a, tc.b)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Diff(%#v, %#v) = %#v, want %#v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiffSliceLenMismatch(t *testing.T) {
+	got := Diff([]int{1, 2, 3}, []int{1, 2, 3, 4})
+	want := []string{"len: 3 != 4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestDiffSliceOfStructs(t *testing.T) {
+	a := []point{{1, 2}, {3, 4}}
+	b := []point{{1, 2}, {3, 5}}
+	got := Diff(a, b)
+	want := []string{"[1].Y: 4 != 5"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestDiffMapMissingKeys(t *testing.T) {
+	a := map[string]int{"x": 1}
+	b := map[string]int{"y": 1}
+	got := Diff(a, b)
+	if len(got) != 2 {
+		t.Fatalf("got %#v, want 2 entries", got)
+	}
+}
+
+// TestDiffMapNonStringKey is a regression test: map keys used to always be
+// rendered with %q, which only reads sensibly for strings.
+func TestDiffMapNonStringKey(t *testing.T) {
+	got := Diff(map[int]int{}, map[int]int{1: 1})
+	want := []string{"key 1: missing in obtained"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+// TestDiffStructWithUnexportedField is a regression test: walking a value
+// reached through a map (which is never addressable on its own) used to
+// panic in exported() when it contained an unexported field.
+func TestDiffStructWithUnexportedField(t *testing.T) {
+	a := map[string]withUnexported{"k": {Name: "foo", secret: 1}}
+	b := map[string]withUnexported{"k": {Name: "foo", secret: 2}}
+
+	got := Diff(a, b)
+	want := []string{`[k].secret: 1 != 2`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestDiffPointerToStructWithUnexportedField(t *testing.T) {
+	a := &withUnexported{Name: "foo", secret: 1}
+	b := &withUnexported{Name: "foo", secret: 2}
+
+	got := Diff(a, b)
+	want := []string{`secret: 1 != 2`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+}
+
+func TestDiffMaxDiffsTruncates(t *testing.T) {
+	a := []int{0, 1, 2, 3}
+	b := []int{10, 11, 12, 13}
+	got := DiffWithOptions(a, b, Options{MaxDiffs: 2})
+	if len(got) != 2 {
+		t.Fatalf("got %d diffs, want 2: %#v", len(got), got)
+	}
+}
+
+func TestSummarizeReportsTruncation(t *testing.T) {
+	a := []int{0, 1, 2, 3}
+	b := []int{10, 11, 12, 13}
+	got := Summarize(a, b, Options{MaxDiffs: 1})
+	if !strings.HasSuffix(got, " ... (more differences omitted)") {
+		t.Errorf("Summarize output missing truncation marker: %q", got)
+	}
+}
@@ -0,0 +1,70 @@
+package encoded_test
+
+import (
+	"testing"
+
+	. "github.com/scale-it/checkers/encoded"
+	gc "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { gc.TestingT(t) }
+
+type JSONSuite struct{}
+
+var _ = gc.Suite(&JSONSuite{})
+
+func (s *JSONSuite) TestJSONEqualsIgnoresWhitespaceKeyOrderAndNumberForm(c *gc.C) {
+	c.Assert(`{"a": 1, "b": [1.0, 2]}`, JSONEquals, `{"b":[1,2.0],"a":1.0}`)
+}
+
+func (s *JSONSuite) TestJSONEqualsReportsFirstDifferingPath(c *gc.C) {
+	result, err := JSONEquals.Check([]interface{}{
+		`{"users":[{"email":"a@x.com"},{"email":"b@x.com"}]}`,
+		`{"users":[{"email":"a@x.com"},{"email":"c@x.com"}]}`,
+	}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, gc.Matches, `\$\.users\[1\]\.email.*`)
+}
+
+func (s *JSONSuite) TestJSONEqualsMissingKeyInObtained(c *gc.C) {
+	result, err := JSONEquals.Check([]interface{}{
+		`{"a":1}`,
+		`{"a":1,"b":2}`,
+	}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, gc.Matches, `.*missing key "b" in obtained.*`)
+}
+
+func (s *JSONSuite) TestJSONEqualsExtraKeyInObtained(c *gc.C) {
+	result, err := JSONEquals.Check([]interface{}{
+		`{"a":1,"b":2}`,
+		`{"a":1}`,
+	}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, gc.Matches, `.*unexpected key "b" in obtained.*`)
+}
+
+func (s *JSONSuite) TestJSONContainsSubsetAndMultisetArrays(c *gc.C) {
+	c.Assert(
+		`{"a":1,"b":2,"list":[3,1,2]}`,
+		JSONContains,
+		`{"a":1,"list":[1,2]}`,
+	)
+}
+
+func (s *JSONSuite) TestJSONContainsMissingKeyFails(c *gc.C) {
+	result, err := JSONContains.Check([]interface{}{
+		`{"a":1}`,
+		`{"a":1,"b":2}`,
+	}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err != "", gc.Equals, true)
+}
+
+func (s *JSONSuite) TestJSONContainsOrderedRequiresMatchingOrder(c *gc.C) {
+	result, _ := JSONContainsOrdered.Check([]interface{}{
+		`{"list":[2,1]}`,
+		`{"list":[1,2]}`,
+	}, []string{"obtained", "expected"})
+	c.Assert(result, gc.Equals, false)
+}
@@ -0,0 +1,93 @@
+package encoded
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	gc "gopkg.in/check.v1"
+)
+
+// decodeJSON parses v, which may be a string, []byte, json.RawMessage, or
+// io.Reader, into out.
+func decodeJSON(v interface{}, out interface{}) error {
+	switch b := v.(type) {
+	case string:
+		return json.Unmarshal([]byte(b), out)
+	case []byte:
+		return json.Unmarshal(b, out)
+	case json.RawMessage:
+		return json.Unmarshal(b, out)
+	case io.Reader:
+		data, err := ioutil.ReadAll(b)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, out)
+	default:
+		return fmt.Errorf("unsupported type %T, want string, []byte, json.RawMessage or io.Reader", v)
+	}
+}
+
+// -----------------------------------------------------------------------
+type jsonEqualsChecker struct {
+	*gc.CheckerInfo
+}
+
+// JSONEquals checker parses both operands as JSON and compares the resulting
+// trees semantically, so whitespace, key order, and numeric representation
+// (1 vs 1.0) don't cause spurious failures. Either operand may be a string,
+// []byte, json.RawMessage, or io.Reader. On mismatch the error names the
+// first differing JSON path, e.g. "$.users[2].email: ... != ...".
+var JSONEquals gc.Checker = &jsonEqualsChecker{
+	&gc.CheckerInfo{Name: "JSONEquals", Params: []string{"obtained", "expected"}}}
+
+func (c *jsonEqualsChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	var obtained, expected interface{}
+	if err := decodeJSON(params[0], &obtained); err != nil {
+		return false, fmt.Sprintf("obtained value is not valid JSON: %v", err)
+	}
+	if err := decodeJSON(params[1], &expected); err != nil {
+		return false, fmt.Sprintf("expected value is not valid JSON: %v", err)
+	}
+	if d := treeDiff("", obtained, expected); d != "" {
+		return false, d
+	}
+	return true, ""
+}
+
+// -----------------------------------------------------------------------
+type jsonContainsChecker struct {
+	*gc.CheckerInfo
+	ordered bool
+}
+
+// JSONContains checker parses both operands as JSON and verifies that every
+// key/value present in the expected tree is also present in the obtained
+// tree: a recursive subset match. Arrays are compared as multisets, i.e. each
+// expected element only needs a matching element somewhere in the
+// corresponding obtained array.
+var JSONContains gc.Checker = &jsonContainsChecker{
+	CheckerInfo: &gc.CheckerInfo{Name: "JSONContains", Params: []string{"obtained", "expected"}}}
+
+// JSONContainsOrdered is like JSONContains, but requires array elements to
+// line up by index instead of matching as a multiset.
+var JSONContainsOrdered gc.Checker = &jsonContainsChecker{
+	CheckerInfo: &gc.CheckerInfo{Name: "JSONContainsOrdered", Params: []string{"obtained", "expected"}},
+	ordered:     true,
+}
+
+func (c *jsonContainsChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	var obtained, expected interface{}
+	if err := decodeJSON(params[0], &obtained); err != nil {
+		return false, fmt.Sprintf("obtained value is not valid JSON: %v", err)
+	}
+	if err := decodeJSON(params[1], &expected); err != nil {
+		return false, fmt.Sprintf("expected value is not valid JSON: %v", err)
+	}
+	if d := containsDiff("", expected, obtained, c.ordered); d != "" {
+		return false, d
+	}
+	return true, ""
+}
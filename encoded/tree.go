@@ -0,0 +1,169 @@
+// Package encoded provides checkers that compare JSON/YAML encoded values by
+// parsing them and comparing the resulting trees, rather than the raw text,
+// so whitespace, key order, and numeric representation don't cause spurious
+// failures.
+package encoded
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// treeDiff walks two decoded JSON/YAML trees and returns a description of the
+// first difference found, or "" if the trees are equal.
+func treeDiff(path string, a, b interface{}) string {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return fmt.Sprintf("%s: type mismatch: object vs %T", displayPath(path), b)
+		}
+		for k, aval := range av {
+			bval, ok := bv[k]
+			if !ok {
+				return fmt.Sprintf("%s: unexpected key %q in obtained", displayPath(path), k)
+			}
+			if d := treeDiff(joinKey(path, k), aval, bval); d != "" {
+				return d
+			}
+		}
+		for k := range bv {
+			if _, ok := av[k]; !ok {
+				return fmt.Sprintf("%s: missing key %q in obtained", displayPath(path), k)
+			}
+		}
+		return ""
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			return fmt.Sprintf("%s: type mismatch: array vs %T", displayPath(path), b)
+		}
+		if len(av) != len(bv) {
+			return fmt.Sprintf("%s: array length %d != %d", displayPath(path), len(av), len(bv))
+		}
+		for i := range av {
+			if d := treeDiff(joinIndex(path, i), av[i], bv[i]); d != "" {
+				return d
+			}
+		}
+		return ""
+
+	default:
+		if !valuesEqual(a, b) {
+			return fmt.Sprintf("%s: %v != %v", displayPath(path), a, b)
+		}
+		return ""
+	}
+}
+
+// containsDiff reports the first reason obtained does not contain expected:
+// every key/value of expected must be present in obtained, recursively;
+// arrays are treated as multisets unless ordered is true, in which case
+// expected[i] must be contained in obtained[i].
+func containsDiff(path string, expected, obtained interface{}, ordered bool) string {
+	switch ev := expected.(type) {
+	case map[string]interface{}:
+		ov, ok := obtained.(map[string]interface{})
+		if !ok {
+			return fmt.Sprintf("%s: type mismatch: object vs %T", displayPath(path), obtained)
+		}
+		for k, eval := range ev {
+			oval, ok := ov[k]
+			if !ok {
+				return fmt.Sprintf("%s: missing key %q in obtained", displayPath(path), k)
+			}
+			if d := containsDiff(joinKey(path, k), eval, oval, ordered); d != "" {
+				return d
+			}
+		}
+		return ""
+
+	case []interface{}:
+		ov, ok := obtained.([]interface{})
+		if !ok {
+			return fmt.Sprintf("%s: type mismatch: array vs %T", displayPath(path), obtained)
+		}
+		if ordered {
+			if len(ev) > len(ov) {
+				return fmt.Sprintf("%s: expected %d element(s), obtained has %d", displayPath(path), len(ev), len(ov))
+			}
+			for i, e := range ev {
+				if d := containsDiff(joinIndex(path, i), e, ov[i], ordered); d != "" {
+					return d
+				}
+			}
+			return ""
+		}
+		used := make([]bool, len(ov))
+		for i, e := range ev {
+			found := false
+			for j, o := range ov {
+				if used[j] {
+					continue
+				}
+				if containsDiff("", e, o, ordered) == "" {
+					used[j] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Sprintf("%s: no matching element in obtained", displayPath(joinIndex(path, i)))
+			}
+		}
+		return ""
+
+	default:
+		if !valuesEqual(expected, obtained) {
+			return fmt.Sprintf("%s: %v != %v", displayPath(path), expected, obtained)
+		}
+		return ""
+	}
+}
+
+// valuesEqual compares two decoded scalar values, treating any combination of
+// the numeric types a JSON/YAML decoder can produce (e.g. 1 vs 1.0) as equal
+// when they represent the same number.
+func valuesEqual(a, b interface{}) bool {
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			return af == bf
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func joinIndex(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return "$." + path
+}
@@ -0,0 +1,79 @@
+//go:build yaml
+// +build yaml
+
+package encoded
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	gc "gopkg.in/check.v1"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// decodeYAML parses v, which may be a string, []byte, or io.Reader, into out.
+// Maps in the result are left as yaml.v2 produces them; see normalizeYAML.
+func decodeYAML(v interface{}, out interface{}) error {
+	switch b := v.(type) {
+	case string:
+		return yaml.Unmarshal([]byte(b), out)
+	case []byte:
+		return yaml.Unmarshal(b, out)
+	case io.Reader:
+		data, err := ioutil.ReadAll(b)
+		if err != nil {
+			return err
+		}
+		return yaml.Unmarshal(data, out)
+	default:
+		return fmt.Errorf("unsupported type %T, want string, []byte or io.Reader", v)
+	}
+}
+
+// normalizeYAML rewrites the map[interface{}]interface{} nodes yaml.v2
+// produces into map[string]interface{}, so treeDiff/containsDiff can walk
+// YAML and JSON trees identically.
+func normalizeYAML(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, val := range vv {
+			m[fmt.Sprint(k)] = normalizeYAML(val)
+		}
+		return m
+	case []interface{}:
+		for i, e := range vv {
+			vv[i] = normalizeYAML(e)
+		}
+		return vv
+	default:
+		return v
+	}
+}
+
+// -----------------------------------------------------------------------
+type yamlEqualsChecker struct {
+	*gc.CheckerInfo
+}
+
+// YAMLEquals checker parses both operands as YAML and compares the resulting
+// trees semantically, in the same spirit as JSONEquals. Built only with the
+// "yaml" build tag, so the gopkg.in/yaml.v2 dependency stays optional for
+// callers who only need the JSON checkers.
+var YAMLEquals gc.Checker = &yamlEqualsChecker{
+	&gc.CheckerInfo{Name: "YAMLEquals", Params: []string{"obtained", "expected"}}}
+
+func (c *yamlEqualsChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	var obtained, expected interface{}
+	if err := decodeYAML(params[0], &obtained); err != nil {
+		return false, fmt.Sprintf("obtained value is not valid YAML: %v", err)
+	}
+	if err := decodeYAML(params[1], &expected); err != nil {
+		return false, fmt.Sprintf("expected value is not valid YAML: %v", err)
+	}
+	if d := treeDiff("", normalizeYAML(obtained), normalizeYAML(expected)); d != "" {
+		return false, d
+	}
+	return true, ""
+}
@@ -0,0 +1,122 @@
+package checkers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gc "gopkg.in/check.v1"
+)
+
+// asMatchString returns v as the string to run a pattern against, accepting
+// plain strings as well as anything implementing fmt.Stringer.
+func asMatchString(v interface{}) (string, bool) {
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case fmt.Stringer:
+		return s.String(), true
+	}
+	return "", false
+}
+
+func compilePatterns(patterns []string) []*regexp.Regexp {
+	res := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		res[i] = regexp.MustCompile("^(?:" + p + ")$")
+	}
+	return res
+}
+
+// -----------------------------------------------------------------------
+type matchesAllChecker struct {
+	*gc.CheckerInfo
+	patterns []string
+	res      []*regexp.Regexp
+}
+
+// MatchesAll returns a checker that succeeds if the obtained value (a string
+// or a fmt.Stringer) matches every one of the given regular expressions, in
+// the same full-string sense as the standard Matches checker.
+func MatchesAll(patterns ...string) gc.Checker {
+	return &matchesAllChecker{
+		CheckerInfo: &gc.CheckerInfo{Name: "MatchesAll", Params: []string{"obtained"}},
+		patterns:    patterns,
+		res:         compilePatterns(patterns),
+	}
+}
+
+func (c *matchesAllChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	value, ok := asMatchString(params[0])
+	if !ok {
+		return false, fmt.Sprintf("obtained value is not a string and has no .String(): %#v", params[0])
+	}
+	var failed []string
+	for i, re := range c.res {
+		if !re.MatchString(value) {
+			failed = append(failed, c.patterns[i])
+		}
+	}
+	if len(failed) == 0 {
+		return true, ""
+	}
+	return false, fmt.Sprintf("value does not match pattern(s): %s", strings.Join(failed, ", "))
+}
+
+// -----------------------------------------------------------------------
+type matchesAnyChecker struct {
+	*gc.CheckerInfo
+	patterns []string
+	res      []*regexp.Regexp
+}
+
+// MatchesAny returns a checker that succeeds if the obtained value (a string
+// or a fmt.Stringer) matches at least one of the given regular expressions.
+func MatchesAny(patterns ...string) gc.Checker {
+	return &matchesAnyChecker{
+		CheckerInfo: &gc.CheckerInfo{Name: "MatchesAny", Params: []string{"obtained"}},
+		patterns:    patterns,
+		res:         compilePatterns(patterns),
+	}
+}
+
+func (c *matchesAnyChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	value, ok := asMatchString(params[0])
+	if !ok {
+		return false, fmt.Sprintf("obtained value is not a string and has no .String(): %#v", params[0])
+	}
+	for _, re := range c.res {
+		if re.MatchString(value) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("value matches none of pattern(s): %s", strings.Join(c.patterns, ", "))
+}
+
+// -----------------------------------------------------------------------
+type notChecker struct {
+	inner gc.Checker
+}
+
+// Not adapts a checker to invert its result: the returned checker succeeds
+// exactly when inner fails, e.g. c.Assert(x, checkers.Not(checkers.Contains), y).
+func Not(inner gc.Checker) gc.Checker {
+	return &notChecker{inner: inner}
+}
+
+func (c *notChecker) Info() *gc.CheckerInfo {
+	info := *c.inner.Info()
+	info.Name = "Not(" + info.Name + ")"
+	return &info
+}
+
+func (c *notChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	ok, innerError := c.inner.Check(params, names)
+	if !ok {
+		return true, ""
+	}
+	if innerError != "" {
+		return false, fmt.Sprintf("unexpectedly matched: %s", innerError)
+	}
+	return false, fmt.Sprintf("unexpectedly matched %s", c.inner.Info().Name)
+}
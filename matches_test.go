@@ -0,0 +1,45 @@
+package checkers_test
+
+import (
+	"testing"
+
+	. "github.com/scale-it/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { gc.TestingT(t) }
+
+type MatchesSuite struct{}
+
+var _ = gc.Suite(&MatchesSuite{})
+
+func (s *MatchesSuite) TestMatchesAllSucceeds(c *gc.C) {
+	c.Assert("hello world", MatchesAll("hello.*", ".*world"))
+}
+
+func (s *MatchesSuite) TestMatchesAllReportsFailedPatterns(c *gc.C) {
+	result, err := MatchesAll("hello.*", "goodbye.*").Check(
+		[]interface{}{"hello world"}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, gc.Matches, ".*goodbye.*")
+}
+
+func (s *MatchesSuite) TestMatchesAnySucceedsOnOneMatch(c *gc.C) {
+	c.Assert("hello world", MatchesAny("goodbye.*", ".*world"))
+}
+
+func (s *MatchesSuite) TestMatchesAnyFailsWhenNoneMatch(c *gc.C) {
+	result, _ := MatchesAny("goodbye.*", "farewell.*").Check(
+		[]interface{}{"hello world"}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+}
+
+func (s *MatchesSuite) TestNotInvertsContains(c *gc.C) {
+	c.Assert([]int{1, 2, 3}, Not(Contains), 4)
+}
+
+func (s *MatchesSuite) TestNotFailsWhenInnerMatches(c *gc.C) {
+	result, err := Not(Contains).Check([]interface{}{[]int{1, 2, 3}, 2}, []string{"obtained", "value"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err != "", gc.Equals, true)
+}
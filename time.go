@@ -7,24 +7,52 @@ import (
 	gc "gopkg.in/check.v1"
 )
 
-// TimeBetween returns a time between checker
+// boundMode controls which of TimeBetween's start/end bounds are inclusive.
+type boundMode int
+
+const (
+	inclusiveBounds boundMode = iota
+	exclusiveBounds
+	halfOpenBounds // [start, end)
+)
+
+// TimeBetween returns a checker that succeeds when the obtained time falls
+// between start and end, both bounds inclusive. Both the bounds and the
+// obtained value are normalized with t.Round(0) before comparison, which
+// strips any monotonic clock reading so the comparison stays correct across
+// DST changes and values that went through (un)marshalling.
 func TimeBetween(start, end time.Time) gc.Checker {
-	if end.Before(start) {
-		return &timeBetweenChecker{end, start}
-	}
-	return &timeBetweenChecker{start, end}
+	return newTimeBetweenChecker("TimeBetween", start, end, inclusiveBounds)
+}
+
+// TimeBetweenExclusive is like TimeBetween, but fails if the obtained value
+// equals either bound.
+func TimeBetweenExclusive(start, end time.Time) gc.Checker {
+	return newTimeBetweenChecker("TimeBetweenExclusive", start, end, exclusiveBounds)
+}
+
+// TimeBetweenHalfOpen is like TimeBetween, but excludes the end bound: it
+// succeeds for an obtained value in [start, end).
+func TimeBetweenHalfOpen(start, end time.Time) gc.Checker {
+	return newTimeBetweenChecker("TimeBetweenHalfOpen", start, end, halfOpenBounds)
 }
 
 type timeBetweenChecker struct {
+	name       string
 	start, end time.Time
+	mode       boundMode
 }
 
-func (checker *timeBetweenChecker) Info() *gc.CheckerInfo {
-	info := gc.CheckerInfo{
-		Name:   "TimeBetween",
-		Params: []string{"obtained"},
+func newTimeBetweenChecker(name string, start, end time.Time, mode boundMode) gc.Checker {
+	start, end = start.Round(0), end.Round(0)
+	if end.Before(start) {
+		start, end = end, start
 	}
-	return &info
+	return &timeBetweenChecker{name: name, start: start, end: end, mode: mode}
+}
+
+func (checker *timeBetweenChecker) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{Name: checker.name, Params: []string{"obtained"}}
 }
 
 func (checker *timeBetweenChecker) Check(params []interface{}, names []string) (result bool, error string) {
@@ -32,11 +60,62 @@ func (checker *timeBetweenChecker) Check(params []interface{}, names []string) (
 	if !ok {
 		return false, "obtained value type must be time.Time"
 	}
-	if when.Before(checker.start) {
-		return false, fmt.Sprintf("obtained value %#v type must before start value of %#v", when, checker.start)
+	when = when.Round(0)
+
+	beforeStart := when.Before(checker.start)
+	afterEnd := when.After(checker.end)
+	switch checker.mode {
+	case exclusiveBounds:
+		beforeStart = beforeStart || when.Equal(checker.start)
+		afterEnd = afterEnd || when.Equal(checker.end)
+	case halfOpenBounds:
+		afterEnd = afterEnd || when.Equal(checker.end)
+	}
+
+	if beforeStart {
+		return false, fmt.Sprintf("obtained is %s before start", checker.start.Sub(when))
+	}
+	if afterEnd {
+		return false, fmt.Sprintf("obtained is %s after end", when.Sub(checker.end))
+	}
+	return true, ""
+}
+
+// -----------------------------------------------------------------------
+
+// TimeWithin returns a checker that succeeds when the obtained time is within
+// tolerance of target, in either direction. Like TimeBetween, both sides are
+// normalized with t.Round(0) before comparison.
+func TimeWithin(target time.Time, tolerance time.Duration) gc.Checker {
+	return &timeWithinChecker{target: target.Round(0), tolerance: tolerance}
+}
+
+type timeWithinChecker struct {
+	target    time.Time
+	tolerance time.Duration
+}
+
+func (checker *timeWithinChecker) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{Name: "TimeWithin", Params: []string{"obtained"}}
+}
+
+func (checker *timeWithinChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	when, ok := params[0].(time.Time)
+	if !ok {
+		return false, "obtained value type must be time.Time"
+	}
+	when = when.Round(0)
+
+	if when.Before(checker.target) {
+		delta := checker.target.Sub(when)
+		if delta > checker.tolerance {
+			return false, fmt.Sprintf("obtained is %s before target, tolerance is %s", delta, checker.tolerance)
+		}
+		return true, ""
 	}
-	if when.After(checker.end) {
-		return false, fmt.Sprintf("obtained value %#v type must after end value of %#v", when, checker.end)
+	delta := when.Sub(checker.target)
+	if delta > checker.tolerance {
+		return false, fmt.Sprintf("obtained is %s after target, tolerance is %s", delta, checker.tolerance)
 	}
 	return true, ""
 }
@@ -61,4 +140,37 @@ func (checker *durationLessThanChecker) Check(params []interface{}, names []stri
 		return false, "expected value type must be time.Duration"
 	}
 	return obtained.Nanoseconds() < expected.Nanoseconds(), ""
-}
\ No newline at end of file
+}
+
+// -----------------------------------------------------------------------
+
+// DurationBetween returns a checker that succeeds when the obtained duration
+// is between min and max, inclusive, mirroring DurationLessThan.
+func DurationBetween(min, max time.Duration) gc.Checker {
+	if max < min {
+		min, max = max, min
+	}
+	return &durationBetweenChecker{min: min, max: max}
+}
+
+type durationBetweenChecker struct {
+	min, max time.Duration
+}
+
+func (checker *durationBetweenChecker) Info() *gc.CheckerInfo {
+	return &gc.CheckerInfo{Name: "DurationBetween", Params: []string{"obtained"}}
+}
+
+func (checker *durationBetweenChecker) Check(params []interface{}, names []string) (result bool, error string) {
+	obtained, ok := params[0].(time.Duration)
+	if !ok {
+		return false, "obtained value type must be time.Duration"
+	}
+	if obtained < checker.min {
+		return false, fmt.Sprintf("obtained %s is before min %s", obtained, checker.min)
+	}
+	if obtained > checker.max {
+		return false, fmt.Sprintf("obtained %s is after max %s", obtained, checker.max)
+	}
+	return true, ""
+}
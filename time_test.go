@@ -0,0 +1,58 @@
+package checkers_test
+
+import (
+	"time"
+
+	. "github.com/scale-it/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type TimeSuite struct{}
+
+var _ = gc.Suite(&TimeSuite{})
+
+var (
+	start = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	end   = time.Date(2020, 1, 1, 14, 0, 0, 0, time.UTC)
+)
+
+func (s *TimeSuite) TestTimeBetweenInclusive(c *gc.C) {
+	c.Assert(start, TimeBetween(start, end))
+	c.Assert(end, TimeBetween(start, end))
+}
+
+func (s *TimeSuite) TestTimeBetweenExclusiveRejectsBounds(c *gc.C) {
+	result, _ := TimeBetweenExclusive(start, end).Check([]interface{}{start}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+}
+
+func (s *TimeSuite) TestTimeBetweenHalfOpenExcludesEnd(c *gc.C) {
+	c.Assert(start, TimeBetweenHalfOpen(start, end))
+	result, _ := TimeBetweenHalfOpen(start, end).Check([]interface{}{end}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+}
+
+func (s *TimeSuite) TestTimeBetweenReportsSignedDelta(c *gc.C) {
+	before := start.Add(-3 * time.Second)
+	result, err := TimeBetween(start, end).Check([]interface{}{before}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+	c.Assert(err, gc.Matches, ".*3s before start.*")
+}
+
+func (s *TimeSuite) TestTimeWithinTolerance(c *gc.C) {
+	target := start
+	c.Assert(target.Add(500*time.Millisecond), TimeWithin(target, time.Second))
+	c.Assert(target.Add(-500*time.Millisecond), TimeWithin(target, time.Second))
+
+	result, _ := TimeWithin(target, time.Second).Check(
+		[]interface{}{target.Add(2 * time.Second)}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+}
+
+func (s *TimeSuite) TestDurationBetween(c *gc.C) {
+	c.Assert(5*time.Second, DurationBetween(time.Second, 10*time.Second))
+
+	result, _ := DurationBetween(time.Second, 10*time.Second).Check(
+		[]interface{}{20 * time.Second}, []string{"obtained"})
+	c.Assert(result, gc.Equals, false)
+}